@@ -0,0 +1,16 @@
+//go:build !smacker
+
+package tree_sitter_openscad_parser
+
+// #cgo CFLAGS: -std=c11 -fPIC -I../../src
+// #include "../../src/parser.c"
+// #include "../../src/scanner.c"
+import "C"
+
+import "unsafe"
+
+// Language returns the tree-sitter Language for this grammar, for use with
+// github.com/tree-sitter/go-tree-sitter.
+func Language() unsafe.Pointer {
+	return unsafe.Pointer(C.tree_sitter_openscad_parser())
+}
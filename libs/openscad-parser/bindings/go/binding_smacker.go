@@ -0,0 +1,21 @@
+//go:build smacker
+
+package tree_sitter_openscad_parser
+
+// #cgo CFLAGS: -std=c11 -fPIC -I../../src
+// #include "../../src/parser.c"
+// #include "../../src/scanner.c"
+import "C"
+
+import (
+	"unsafe"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// GetLanguage returns a *sitter.Language for this grammar, compatible with
+// github.com/smacker/go-tree-sitter. Select it over the default Language()
+// binding by building with `-tags smacker`.
+func GetLanguage() *sitter.Language {
+	return sitter.NewLanguage(unsafe.Pointer(C.tree_sitter_openscad_parser()))
+}
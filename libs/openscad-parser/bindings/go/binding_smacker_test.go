@@ -0,0 +1,16 @@
+//go:build smacker
+
+package tree_sitter_openscad_parser_test
+
+import (
+	"testing"
+
+	tree_sitter_openscad_parser "github.com/tree-sitter/tree-sitter-openscad_parser/bindings/go"
+)
+
+func TestCanLoadGrammarSmacker(t *testing.T) {
+	language := tree_sitter_openscad_parser.GetLanguage()
+	if language == nil {
+		t.Errorf("Error loading OpenscadParser grammar (smacker binding)")
+	}
+}
@@ -0,0 +1,85 @@
+package tree_sitter_openscad_parser
+
+import (
+	"context"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Editor wraps a Parser and the most recently parsed Tree, and lets callers
+// apply high-level source edits without hand-computing the InputEdit byte
+// offsets and row/column deltas that Tree.Edit needs for an incremental
+// reparse.
+type Editor struct {
+	parser *tree_sitter.Parser
+	tree   *tree_sitter.Tree
+	src    []byte
+}
+
+// NewEditor parses src and returns an Editor ready to accept edits against
+// the resulting tree.
+func NewEditor(parser *tree_sitter.Parser, src []byte) *Editor {
+	tree := parser.Parse(src, nil)
+	return &Editor{parser: parser, tree: tree, src: src}
+}
+
+// Tree returns the most recently parsed tree.
+func (e *Editor) Tree() *tree_sitter.Tree { return e.tree }
+
+// Source returns the current source text.
+func (e *Editor) Source() []byte { return e.src }
+
+// ReplaceRange replaces the text between start and end (row/column
+// positions in the current source) with newText, informs the existing tree
+// of the edit, and reparses incrementally from it.
+func (e *Editor) ReplaceRange(ctx context.Context, start, end tree_sitter.Point, newText []byte) {
+	startByte := byteOffset(e.src, start)
+	oldEndByte := byteOffset(e.src, end)
+
+	newSrc := make([]byte, 0, len(e.src)-int(oldEndByte-startByte)+len(newText))
+	newSrc = append(newSrc, e.src[:startByte]...)
+	newSrc = append(newSrc, newText...)
+	newSrc = append(newSrc, e.src[oldEndByte:]...)
+
+	newEndByte := startByte + uint(len(newText))
+
+	e.tree.Edit(&tree_sitter.InputEdit{
+		StartByte:      startByte,
+		OldEndByte:     oldEndByte,
+		NewEndByte:     newEndByte,
+		StartPosition:  start,
+		OldEndPosition: end,
+		NewEndPosition: pointAfter(start, newText),
+	})
+
+	e.tree = e.parser.ParseCtx(ctx, newSrc, e.tree)
+	e.src = newSrc
+}
+
+// byteOffset converts a row/column position into a byte offset into src.
+func byteOffset(src []byte, p tree_sitter.Point) uint {
+	var row uint
+	var offset uint
+	for offset < uint(len(src)) && row < p.Row {
+		if src[offset] == '\n' {
+			row++
+		}
+		offset++
+	}
+	return offset + p.Column
+}
+
+// pointAfter returns the row/column position reached after inserting text at
+// start.
+func pointAfter(start tree_sitter.Point, text []byte) tree_sitter.Point {
+	row, column := start.Row, start.Column
+	for _, b := range text {
+		if b == '\n' {
+			row++
+			column = 0
+		} else {
+			column++
+		}
+	}
+	return tree_sitter.Point{Row: row, Column: column}
+}
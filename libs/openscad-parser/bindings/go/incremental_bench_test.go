@@ -0,0 +1,60 @@
+package tree_sitter_openscad_parser_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_openscad_parser "github.com/tree-sitter/tree-sitter-openscad_parser/bindings/go"
+)
+
+func newParser(tb testing.TB) *tree_sitter.Parser {
+	tb.Helper()
+
+	language := tree_sitter.NewLanguage(tree_sitter_openscad_parser.Language())
+	parser := tree_sitter.NewParser()
+	if err := parser.SetLanguage(language); err != nil {
+		tb.Fatalf("setting language: %v", err)
+	}
+	return parser
+}
+
+// generateLargeSource builds a synthetic ~5000-line OpenSCAD file so the
+// incremental-vs-full-reparse benchmarks below exercise a realistic tree
+// size instead of a handful of statements.
+func generateLargeSource(lines int) []byte {
+	var b strings.Builder
+	for i := 0; i < lines; i++ {
+		fmt.Fprintf(&b, "cube([%d, %d, %d]);\n", i, i+1, i+2)
+	}
+	return []byte(b.String())
+}
+
+func BenchmarkFullReparse_SmallInLargeFile(b *testing.B) {
+	src := generateLargeSource(5000)
+	parser := newParser(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser.Parse(src, nil)
+	}
+}
+
+func BenchmarkIncrementalEdit_SmallInLargeFile(b *testing.B) {
+	src := generateLargeSource(5000)
+	parser := newParser(b)
+	editor := tree_sitter_openscad_parser.NewEditor(parser, src)
+
+	start := tree_sitter.Point{Row: 2500, Column: 6}
+	end := tree_sitter.Point{Row: 2500, Column: 7}
+
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		digit := []byte{byte('0' + i%10)}
+		editor.ReplaceRange(ctx, start, end, digit)
+	}
+}
@@ -0,0 +1,29 @@
+package tree_sitter_openscad_parser_test
+
+import (
+	"context"
+	"testing"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_openscad_parser "github.com/tree-sitter/tree-sitter-openscad_parser/bindings/go"
+)
+
+func TestEditorReplaceRange(t *testing.T) {
+	parser := newParser(t)
+	editor := tree_sitter_openscad_parser.NewEditor(parser, []byte("cube(1);\n"))
+
+	editor.ReplaceRange(
+		context.Background(),
+		tree_sitter.Point{Row: 0, Column: 5},
+		tree_sitter.Point{Row: 0, Column: 6},
+		[]byte("2"),
+	)
+
+	want := "cube(2);\n"
+	if got := string(editor.Source()); got != want {
+		t.Errorf("Source() = %q, want %q", got, want)
+	}
+	if editor.Tree().RootNode().HasError() {
+		t.Errorf("tree has parse errors after edit: %s", editor.Tree().RootNode().ToSexp())
+	}
+}
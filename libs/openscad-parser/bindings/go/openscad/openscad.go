@@ -0,0 +1,161 @@
+// Package openscad provides typed helpers for querying OpenSCAD syntax
+// trees produced by this grammar, so callers don't have to hand-write
+// tree-sitter queries and cursor-walking code for common lookups.
+package openscad
+
+import (
+	_ "embed"
+	"fmt"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+//go:embed queries/modules.scm
+var modulesQuery string
+
+//go:embed queries/functions.scm
+var functionsQuery string
+
+//go:embed queries/includes.scm
+var includesQuery string
+
+//go:embed queries/uses.scm
+var usesQuery string
+
+// Point is a 0-indexed row/column position within a source file.
+type Point = tree_sitter.Point
+
+// Kind identifies what kind of declaration an identifier resolves to.
+type Kind int
+
+const (
+	KindUnknown Kind = iota
+	KindModule
+	KindFunction
+)
+
+// ModuleDecl describes a single `module` definition.
+type ModuleDecl struct {
+	Name string
+	Node *tree_sitter.Node
+}
+
+// FunctionDecl describes a single `function` definition.
+type FunctionDecl struct {
+	Name string
+	Node *tree_sitter.Node
+}
+
+// IncludePath describes a single `include <...>` or `use <...>` directive.
+type IncludePath struct {
+	Path string
+	Node *tree_sitter.Node
+}
+
+// ListModules returns every module definition in tree, in source order.
+func ListModules(tree *tree_sitter.Tree, src []byte) []ModuleDecl {
+	var modules []ModuleDecl
+	forEachMatch(modulesQuery, tree, src, func(captures map[string]*tree_sitter.Node) {
+		def, ok := captures["module.definition"]
+		name, hasName := captures["module.name"]
+		if !ok || !hasName {
+			return
+		}
+		modules = append(modules, ModuleDecl{Name: textOf(name, src), Node: def})
+	})
+	return modules
+}
+
+// ListFunctions returns every function definition in tree, in source order.
+func ListFunctions(tree *tree_sitter.Tree, src []byte) []FunctionDecl {
+	var functions []FunctionDecl
+	forEachMatch(functionsQuery, tree, src, func(captures map[string]*tree_sitter.Node) {
+		def, ok := captures["function.definition"]
+		name, hasName := captures["function.name"]
+		if !ok || !hasName {
+			return
+		}
+		functions = append(functions, FunctionDecl{Name: textOf(name, src), Node: def})
+	})
+	return functions
+}
+
+// ListIncludes returns every `include <...>` directive in tree, in source order.
+func ListIncludes(tree *tree_sitter.Tree, src []byte) []IncludePath {
+	var includes []IncludePath
+	forEachMatch(includesQuery, tree, src, func(captures map[string]*tree_sitter.Node) {
+		stmt, ok := captures["include.statement"]
+		path, hasPath := captures["include.path"]
+		if !ok || !hasPath {
+			return
+		}
+		includes = append(includes, IncludePath{Path: textOf(path, src), Node: stmt})
+	})
+	return includes
+}
+
+// ListUses returns every `use <...>` directive in tree, in source order.
+func ListUses(tree *tree_sitter.Tree, src []byte) []IncludePath {
+	var uses []IncludePath
+	forEachMatch(usesQuery, tree, src, func(captures map[string]*tree_sitter.Node) {
+		stmt, ok := captures["use.statement"]
+		path, hasPath := captures["use.path"]
+		if !ok || !hasPath {
+			return
+		}
+		uses = append(uses, IncludePath{Path: textOf(path, src), Node: stmt})
+	})
+	return uses
+}
+
+// ResolveIdentifierAt returns the identifier node at pos, and the kind of
+// declaration it belongs to (KindUnknown if it isn't a module or function
+// name, or if pos doesn't land on an identifier at all).
+func ResolveIdentifierAt(tree *tree_sitter.Tree, pos Point) (*tree_sitter.Node, Kind) {
+	node := tree.RootNode().NamedDescendantForPointRange(pos, pos)
+	if node.Kind() != "identifier" {
+		return node, KindUnknown
+	}
+
+	parent := node.Parent()
+	if parent == nil {
+		return node, KindUnknown
+	}
+
+	switch parent.Kind() {
+	case "module_definition":
+		return node, KindModule
+	case "function_definition":
+		return node, KindFunction
+	default:
+		return node, KindUnknown
+	}
+}
+
+// forEachMatch runs querySource against tree and invokes fn once per match,
+// with captures keyed by their name in the query.
+func forEachMatch(querySource string, tree *tree_sitter.Tree, src []byte, fn func(captures map[string]*tree_sitter.Node)) {
+	query, queryErr := tree_sitter.NewQuery(tree.Language(), querySource)
+	if queryErr != nil {
+		panic(fmt.Sprintf("openscad: invalid built-in query: %v", queryErr))
+	}
+	defer query.Close()
+
+	names := query.CaptureNames()
+	cursor := tree_sitter.NewQueryCursor()
+	defer cursor.Close()
+
+	matches := cursor.Matches(query, tree.RootNode(), src)
+	for match := matches.Next(); match != nil; match = matches.Next() {
+		captures := make(map[string]*tree_sitter.Node, len(match.Captures))
+		for _, capture := range match.Captures {
+			node := capture.Node
+			captures[names[capture.Index]] = &node
+		}
+		fn(captures)
+	}
+}
+
+func textOf(node *tree_sitter.Node, src []byte) string {
+	return string(src[node.StartByte():node.EndByte()])
+}
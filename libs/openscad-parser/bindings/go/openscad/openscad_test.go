@@ -0,0 +1,114 @@
+package openscad_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_openscad_parser "github.com/tree-sitter/tree-sitter-openscad_parser/bindings/go"
+	"github.com/tree-sitter/tree-sitter-openscad_parser/bindings/go/openscad"
+)
+
+func parseFixture(t *testing.T, name string) (*tree_sitter.Tree, []byte) {
+	t.Helper()
+
+	src, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("reading fixture %q: %v", name, err)
+	}
+
+	language := tree_sitter.NewLanguage(tree_sitter_openscad_parser.Language())
+	parser := tree_sitter.NewParser()
+	if err := parser.SetLanguage(language); err != nil {
+		t.Fatalf("setting language: %v", err)
+	}
+
+	tree := parser.Parse(src, nil)
+	t.Cleanup(tree.Close)
+	return tree, src
+}
+
+func TestListModules(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    []string
+	}{
+		{fixture: "basic.scad", want: []string{"box"}},
+		{fixture: "empty.scad", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			tree, src := parseFixture(t, tt.fixture)
+
+			var got []string
+			for _, m := range openscad.ListModules(tree, src) {
+				got = append(got, m.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListModules(%s) = %v, want %v", tt.fixture, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListFunctions(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    []string
+	}{
+		{fixture: "basic.scad", want: []string{"double"}},
+		{fixture: "empty.scad", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			tree, src := parseFixture(t, tt.fixture)
+
+			var got []string
+			for _, f := range openscad.ListFunctions(tree, src) {
+				got = append(got, f.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListFunctions(%s) = %v, want %v", tt.fixture, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListIncludesAndUses(t *testing.T) {
+	tree, src := parseFixture(t, "basic.scad")
+
+	var includes []string
+	for _, inc := range openscad.ListIncludes(tree, src) {
+		includes = append(includes, inc.Path)
+	}
+	if want := []string{"<utils.scad>"}; !reflect.DeepEqual(includes, want) {
+		t.Errorf("ListIncludes = %v, want %v", includes, want)
+	}
+
+	var uses []string
+	for _, use := range openscad.ListUses(tree, src) {
+		uses = append(uses, use.Path)
+	}
+	if want := []string{"<helpers.scad>"}; !reflect.DeepEqual(uses, want) {
+		t.Errorf("ListUses = %v, want %v", uses, want)
+	}
+}
+
+func TestResolveIdentifierAt(t *testing.T) {
+	tree, src := parseFixture(t, "basic.scad")
+
+	modules := openscad.ListModules(tree, src)
+	if len(modules) != 1 {
+		t.Fatalf("expected 1 module, got %d", len(modules))
+	}
+
+	namePos := modules[0].Node.ChildByFieldName("name").StartPosition()
+	_, kind := openscad.ResolveIdentifierAt(tree, namePos)
+	if kind != openscad.KindModule {
+		t.Errorf("ResolveIdentifierAt(module name) = %v, want KindModule", kind)
+	}
+}
@@ -0,0 +1,37 @@
+package tree_sitter_openscad_parser
+
+// The .scm files embedded below are copies of ../../queries, kept here
+// because go:embed can't follow a symlink out of the package directory.
+// Run `make -C . queries` after editing ../../queries to resync them.
+
+import _ "embed"
+
+//go:embed queries/highlights.scm
+var highlights []byte
+
+//go:embed queries/locals.scm
+var locals []byte
+
+//go:embed queries/injections.scm
+var injections []byte
+
+//go:embed queries/folds.scm
+var folds []byte
+
+//go:embed queries/indents.scm
+var indents []byte
+
+// Highlights returns the tree-sitter highlight query for this grammar.
+func Highlights() []byte { return highlights }
+
+// Locals returns the tree-sitter locals query for this grammar.
+func Locals() []byte { return locals }
+
+// Injections returns the tree-sitter language-injection query for this grammar.
+func Injections() []byte { return injections }
+
+// Folds returns the tree-sitter folding-range query for this grammar.
+func Folds() []byte { return folds }
+
+// Indents returns the tree-sitter indentation query for this grammar.
+func Indents() []byte { return indents }
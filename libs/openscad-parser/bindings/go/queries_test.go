@@ -0,0 +1,31 @@
+package tree_sitter_openscad_parser_test
+
+import (
+	"testing"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_openscad_parser "github.com/tree-sitter/tree-sitter-openscad_parser/bindings/go"
+)
+
+func TestQueriesCompile(t *testing.T) {
+	language := tree_sitter.NewLanguage(tree_sitter_openscad_parser.Language())
+
+	queries := map[string][]byte{
+		"highlights": tree_sitter_openscad_parser.Highlights(),
+		"locals":     tree_sitter_openscad_parser.Locals(),
+		"injections": tree_sitter_openscad_parser.Injections(),
+		"folds":      tree_sitter_openscad_parser.Folds(),
+		"indents":    tree_sitter_openscad_parser.Indents(),
+	}
+
+	for name, source := range queries {
+		name, source := name, source
+		t.Run(name, func(t *testing.T) {
+			query, queryErr := tree_sitter.NewQuery(language, string(source))
+			if queryErr != nil {
+				t.Fatalf("compiling %s.scm: %v", name, queryErr)
+			}
+			defer query.Close()
+		})
+	}
+}
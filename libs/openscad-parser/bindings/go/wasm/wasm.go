@@ -0,0 +1,29 @@
+//go:build wasm_grammar
+
+// Package wasm loads this grammar's WASM build through go-tree-sitter's
+// WASM language store, for use in sandboxed or cgo-free environments (plugin
+// hosts, browsers via wazero, or CI images without a C toolchain).
+//
+// The embedded .wasm file is a build artifact and isn't checked into version
+// control: run `make -C bindings/wasm` to produce it, then copy or symlink
+// the result to tree-sitter-openscad_parser.wasm in this directory before
+// building with `-tags wasm_grammar`. Without that file present, this
+// package fails to compile, so it's gated behind the build tag rather than
+// included by default.
+package wasm
+
+import (
+	"context"
+	_ "embed"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+//go:embed tree-sitter-openscad_parser.wasm
+var grammarWasm []byte
+
+// LoadLanguage loads this grammar's WASM build into store and returns the
+// resulting Language.
+func LoadLanguage(ctx context.Context, store *tree_sitter.WasmStore) (*tree_sitter.Language, error) {
+	return store.LoadLanguage(ctx, "openscad_parser", grammarWasm)
+}
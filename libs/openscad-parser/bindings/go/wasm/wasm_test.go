@@ -0,0 +1,29 @@
+//go:build wasm_grammar
+
+package wasm_test
+
+import (
+	"context"
+	"testing"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	"github.com/tree-sitter/tree-sitter-openscad_parser/bindings/go/wasm"
+)
+
+func TestCanLoadWasmGrammar(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := tree_sitter.NewWasmStore()
+	if err != nil {
+		t.Fatalf("creating wasm store: %v", err)
+	}
+	defer store.Close()
+
+	language, err := wasm.LoadLanguage(ctx, store)
+	if err != nil {
+		t.Fatalf("loading wasm grammar: %v", err)
+	}
+	if language == nil {
+		t.Errorf("Error loading OpenscadParser grammar (wasm binding)")
+	}
+}